@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 
 	"time"
@@ -23,6 +24,8 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 			State: resourceCloudflareLoadBalancerImport,
 		},
 
+		CustomizeDiff: resourceCloudflareLoadBalancerValidateRulePriorities,
+
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -96,10 +99,24 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 			"steering_policy": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", ""}, false),
+				ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", "proximity", ""}, false),
 				Computed:     true,
 			},
 
+			"session_affinity_ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"session_affinity_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     sessionAffinityAttributesElem,
+			},
+
 			// nb enterprise only
 			"pop_pools": {
 				Type:     schema.TypeSet,
@@ -115,6 +132,19 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 				Elem:     regionPoolElem,
 			},
 
+			"country_pools": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     countryPoolElem,
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     loadBalancerRuleElem,
+			},
+
 			"created_on": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -166,9 +196,228 @@ var regionPoolElem = &schema.Resource{
 	},
 }
 
+var countryPoolElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"country": {
+			Type:     schema.TypeString,
+			Required: true,
+			// let the api handle validating countries
+		},
+
+		"pool_ids": {
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	},
+}
+
 var localPoolElems = map[string]*schema.Resource{
-	"pop":    popPoolElem,
-	"region": regionPoolElem,
+	"pop":     popPoolElem,
+	"region":  regionPoolElem,
+	"country": countryPoolElem,
+}
+
+var sessionAffinityAttributesElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"samesite": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Strict", "Lax", "None", ""}, false),
+		},
+
+		"secure": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Always", "Never", "Auto", ""}, false),
+		},
+
+		"drain_duration": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		"zero_downtime_failover": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"none", "temporary", "sticky", ""}, false),
+		},
+	},
+}
+
+// ruleOverridesSessionAffinityAttributesElem is the rule-overrides
+// counterpart to sessionAffinityAttributesElem. cloudflare.LoadBalancerRuleOverrides
+// only carries SameSite/Secure via LoadBalancerRuleOverridesSessionAffinityAttrs,
+// not the full SessionAffinityAttributes type used on the load balancer itself.
+var ruleOverridesSessionAffinityAttributesElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"samesite": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Strict", "Lax", "None", ""}, false),
+		},
+
+		"secure": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Always", "Never", "Auto", ""}, false),
+		},
+	},
+}
+
+var loadBalancerRuleElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"condition": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"priority": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+
+		"disabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+
+		"terminates": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+
+		"fixed_response": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     fixedResponseElem,
+		},
+
+		"overrides": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     loadBalancerRuleOverridesElem,
+		},
+	},
+}
+
+var fixedResponseElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"message_body": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"status_code": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		"content_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"location": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var loadBalancerRuleOverridesElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"default_pools": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+
+		"fallback_pool": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 32),
+		},
+
+		"ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		"session_affinity": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"none", "cookie"}, false),
+		},
+
+		"session_affinity_ttl": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		"session_affinity_attributes": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     ruleOverridesSessionAffinityAttributesElem,
+		},
+
+		"steering_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"off", "geo", "dynamic_latency", "random", "proximity", ""}, false),
+		},
+
+		"pop_pools": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     popPoolElem,
+		},
+
+		"region_pools": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     regionPoolElem,
+		},
+
+		"country_pools": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     countryPoolElem,
+		},
+	},
+}
+
+func resourceCloudflareLoadBalancerValidateRulePriorities(d *schema.ResourceDiff, meta interface{}) error {
+	rules, ok := d.GetOk("rules")
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[int]bool)
+	for _, rawRule := range rules.([]interface{}) {
+		rule := rawRule.(map[string]interface{})
+		priority := rule["priority"].(int)
+		if seen[priority] {
+			return fmt.Errorf("duplicate rule priority %d specified; each rule must have a unique priority", priority)
+		}
+		seen[priority] = true
+	}
+
+	return nil
 }
 
 func resourceCloudflareLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -220,6 +469,26 @@ func resourceCloudflareLoadBalancerCreate(d *schema.ResourceData, meta interface
 		newLoadBalancer.PopPools = expandedPopPools
 	}
 
+	if countryPools, ok := d.GetOk("country_pools"); ok {
+		expandedCountryPools, err := expandGeoPools(countryPools, "country")
+		if err != nil {
+			return err
+		}
+		newLoadBalancer.CountryPools = expandedCountryPools
+	}
+
+	if sessionAffinityTTL, ok := d.GetOk("session_affinity_ttl"); ok {
+		newLoadBalancer.PersistenceTTL = sessionAffinityTTL.(int)
+	}
+
+	if attrs, ok := d.GetOk("session_affinity_attributes"); ok {
+		newLoadBalancer.SessionAffinityAttributes = expandSessionAffinityAttributes(attrs.([]interface{}))
+	}
+
+	if rules, ok := d.GetOk("rules"); ok {
+		newLoadBalancer.Rules = expandLoadBalancerRules(rules.([]interface{}))
+	}
+
 	if zoneID == "" {
 		var err error
 		zoneID, err = client.ZoneIDByName(zoneName)
@@ -286,6 +555,26 @@ func resourceCloudflareLoadBalancerUpdate(d *schema.ResourceData, meta interface
 		loadBalancer.PopPools = expandedPopPools
 	}
 
+	if countryPools, ok := d.GetOk("country_pools"); ok {
+		expandedCountryPools, err := expandGeoPools(countryPools, "country")
+		if err != nil {
+			return err
+		}
+		loadBalancer.CountryPools = expandedCountryPools
+	}
+
+	if sessionAffinityTTL, ok := d.GetOk("session_affinity_ttl"); ok {
+		loadBalancer.PersistenceTTL = sessionAffinityTTL.(int)
+	}
+
+	if attrs, ok := d.GetOk("session_affinity_attributes"); ok {
+		loadBalancer.SessionAffinityAttributes = expandSessionAffinityAttributes(attrs.([]interface{}))
+	}
+
+	if rules, ok := d.GetOk("rules"); ok {
+		loadBalancer.Rules = expandLoadBalancerRules(rules.([]interface{}))
+	}
+
 	log.Printf("[INFO] Updating Cloudflare Load Balancer from struct: %+v", loadBalancer)
 
 	_, err := client.ModifyLoadBalancer(zoneID, loadBalancer)
@@ -336,6 +625,7 @@ func resourceCloudflareLoadBalancerRead(d *schema.ResourceData, meta interface{}
 	d.Set("ttl", loadBalancer.TTL)
 	d.Set("steering_policy", loadBalancer.SteeringPolicy)
 	d.Set("session_affinity", loadBalancer.Persistence)
+	d.Set("session_affinity_ttl", loadBalancer.PersistenceTTL)
 	d.Set("created_on", loadBalancer.CreatedOn.Format(time.RFC3339Nano))
 	d.Set("modified_on", loadBalancer.ModifiedOn.Format(time.RFC3339Nano))
 
@@ -351,6 +641,18 @@ func resourceCloudflareLoadBalancerRead(d *schema.ResourceData, meta interface{}
 		log.Printf("[WARN] Error setting region_pools on load balancer %q: %s", d.Id(), err)
 	}
 
+	if err := d.Set("country_pools", flattenGeoPools(loadBalancer.CountryPools, "country")); err != nil {
+		log.Printf("[WARN] Error setting country_pools on load balancer %q: %s", d.Id(), err)
+	}
+
+	if err := d.Set("session_affinity_attributes", flattenSessionAffinityAttributes(loadBalancer.SessionAffinityAttributes)); err != nil {
+		log.Printf("[WARN] Error setting session_affinity_attributes on load balancer %q: %s", d.Id(), err)
+	}
+
+	if err := d.Set("rules", flattenLoadBalancerRules(loadBalancer.Rules)); err != nil {
+		log.Printf("[WARN] Error setting rules on load balancer %q: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -366,6 +668,206 @@ func flattenGeoPools(pools map[string][]string, geoType string) *schema.Set {
 	return schema.NewSet(schema.HashResource(localPoolElems[geoType]), flattened)
 }
 
+func expandSessionAffinityAttributes(list []interface{}) *cloudflare.SessionAffinityAttributes {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.SessionAffinityAttributes{
+		SameSite:             cfg["samesite"].(string),
+		Secure:               cfg["secure"].(string),
+		DrainDuration:        cfg["drain_duration"].(int),
+		ZeroDowntimeFailover: cfg["zero_downtime_failover"].(string),
+	}
+}
+
+func flattenSessionAffinityAttributes(attrs *cloudflare.SessionAffinityAttributes) []interface{} {
+	if attrs == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"samesite":               attrs.SameSite,
+			"secure":                 attrs.Secure,
+			"drain_duration":         attrs.DrainDuration,
+			"zero_downtime_failover": attrs.ZeroDowntimeFailover,
+		},
+	}
+}
+
+func expandRuleOverridesSessionAffinityAttributes(list []interface{}) *cloudflare.LoadBalancerRuleOverridesSessionAffinityAttrs {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.LoadBalancerRuleOverridesSessionAffinityAttrs{
+		SameSite: cfg["samesite"].(string),
+		Secure:   cfg["secure"].(string),
+	}
+}
+
+func flattenRuleOverridesSessionAffinityAttributes(attrs *cloudflare.LoadBalancerRuleOverridesSessionAffinityAttrs) []interface{} {
+	if attrs == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"samesite": attrs.SameSite,
+			"secure":   attrs.Secure,
+		},
+	}
+}
+
+func expandLoadBalancerRules(list []interface{}) []*cloudflare.LoadBalancerRule {
+	rules := make([]*cloudflare.LoadBalancerRule, 0, len(list))
+	for _, raw := range list {
+		cfg := raw.(map[string]interface{})
+		rule := &cloudflare.LoadBalancerRule{
+			Name:       cfg["name"].(string),
+			Condition:  cfg["condition"].(string),
+			Priority:   cfg["priority"].(int),
+			Disabled:   cfg["disabled"].(bool),
+			Terminates: cfg["terminates"].(bool),
+		}
+
+		if fixedResponse, ok := cfg["fixed_response"].([]interface{}); ok && len(fixedResponse) > 0 {
+			rule.FixedResponse = expandLoadBalancerFixedResponse(fixedResponse)
+		}
+
+		if overrides, ok := cfg["overrides"].([]interface{}); ok && len(overrides) > 0 {
+			if expanded := expandLoadBalancerRuleOverrides(overrides); expanded != nil {
+				rule.Overrides = *expanded
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenLoadBalancerRules(rules []*cloudflare.LoadBalancerRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		cfg := map[string]interface{}{
+			"name":           rule.Name,
+			"condition":      rule.Condition,
+			"priority":       rule.Priority,
+			"disabled":       rule.Disabled,
+			"terminates":     rule.Terminates,
+			"fixed_response": flattenLoadBalancerFixedResponse(rule.FixedResponse),
+			"overrides":      flattenLoadBalancerRuleOverrides(emptyLoadBalancerRuleOverridesToNil(rule.Overrides)),
+		}
+		flattened = append(flattened, cfg)
+	}
+	return flattened
+}
+
+func expandLoadBalancerFixedResponse(list []interface{}) *cloudflare.LoadBalancerFixedResponseData {
+	if list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.LoadBalancerFixedResponseData{
+		MessageBody: cfg["message_body"].(string),
+		StatusCode:  cfg["status_code"].(int),
+		ContentType: cfg["content_type"].(string),
+		Location:    cfg["location"].(string),
+	}
+}
+
+func flattenLoadBalancerFixedResponse(fixedResponse *cloudflare.LoadBalancerFixedResponseData) []interface{} {
+	if fixedResponse == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"message_body": fixedResponse.MessageBody,
+			"status_code":  fixedResponse.StatusCode,
+			"content_type": fixedResponse.ContentType,
+			"location":     fixedResponse.Location,
+		},
+	}
+}
+
+func expandLoadBalancerRuleOverrides(list []interface{}) *cloudflare.LoadBalancerRuleOverrides {
+	if list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	overrides := &cloudflare.LoadBalancerRuleOverrides{
+		FallbackPool:   cfg["fallback_pool"].(string),
+		DefaultPools:   expandInterfaceToStringList(cfg["default_pools"]),
+		TTL:            uint(cfg["ttl"].(int)),
+		SteeringPolicy: cfg["steering_policy"].(string),
+		Persistence:    cfg["session_affinity"].(string),
+	}
+
+	if sessionAffinityTTL, ok := cfg["session_affinity_ttl"].(int); ok && sessionAffinityTTL > 0 {
+		v := uint(sessionAffinityTTL)
+		overrides.PersistenceTTL = &v
+	}
+
+	if popPools, ok := cfg["pop_pools"]; ok {
+		if expandedPopPools, err := expandGeoPools(popPools, "pop"); err == nil && len(expandedPopPools) > 0 {
+			overrides.PoPPools = expandedPopPools
+		}
+	}
+
+	if regionPools, ok := cfg["region_pools"]; ok {
+		if expandedRegionPools, err := expandGeoPools(regionPools, "region"); err == nil && len(expandedRegionPools) > 0 {
+			overrides.RegionPools = expandedRegionPools
+		}
+	}
+
+	if countryPools, ok := cfg["country_pools"]; ok {
+		if expandedCountryPools, err := expandGeoPools(countryPools, "country"); err == nil && len(expandedCountryPools) > 0 {
+			overrides.CountryPools = expandedCountryPools
+		}
+	}
+
+	if attrs, ok := cfg["session_affinity_attributes"].([]interface{}); ok && len(attrs) > 0 {
+		overrides.SessionAffinityAttrs = expandRuleOverridesSessionAffinityAttributes(attrs)
+	}
+
+	return overrides
+}
+
+// emptyLoadBalancerRuleOverridesToNil returns nil when overrides is the zero
+// value, since LoadBalancerRule.Overrides is a value type and can't
+// otherwise be distinguished from "not configured".
+func emptyLoadBalancerRuleOverridesToNil(overrides cloudflare.LoadBalancerRuleOverrides) *cloudflare.LoadBalancerRuleOverrides {
+	if reflect.DeepEqual(overrides, cloudflare.LoadBalancerRuleOverrides{}) {
+		return nil
+	}
+	return &overrides
+}
+
+func flattenLoadBalancerRuleOverrides(overrides *cloudflare.LoadBalancerRuleOverrides) []interface{} {
+	if overrides == nil {
+		return []interface{}{}
+	}
+
+	var sessionAffinityTTL uint
+	if overrides.PersistenceTTL != nil {
+		sessionAffinityTTL = *overrides.PersistenceTTL
+	}
+
+	cfg := map[string]interface{}{
+		"fallback_pool":               overrides.FallbackPool,
+		"default_pools":               flattenStringList(overrides.DefaultPools),
+		"ttl":                         overrides.TTL,
+		"steering_policy":             overrides.SteeringPolicy,
+		"session_affinity":            overrides.Persistence,
+		"session_affinity_ttl":        sessionAffinityTTL,
+		"session_affinity_attributes": flattenRuleOverridesSessionAffinityAttributes(overrides.SessionAffinityAttrs),
+		"pop_pools":                   flattenGeoPools(overrides.PoPPools, "pop"),
+		"region_pools":                flattenGeoPools(overrides.RegionPools, "region"),
+		"country_pools":               flattenGeoPools(overrides.CountryPools, "country"),
+	}
+
+	return []interface{}{cfg}
+}
+
 func resourceCloudflareLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
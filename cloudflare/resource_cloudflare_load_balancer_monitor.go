@@ -0,0 +1,259 @@
+package cloudflare
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareLoadBalancerMonitorCreate,
+		Read:   resourceCloudflareLoadBalancerMonitorRead,
+		Update: resourceCloudflareLoadBalancerMonitorUpdate,
+		Delete: resourceCloudflareLoadBalancerMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "http",
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp", "udp_icmp", "icmp_ping", "smtp"}, false),
+			},
+
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/",
+			},
+
+			"header": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeList,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  5,
+			},
+
+			"retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  2,
+			},
+
+			"interval": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  60,
+			},
+
+			"expected_body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"expected_codes": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"follow_redirects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"allow_insecure": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"probe_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCloudflareLoadBalancerMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	newMonitor := cloudflare.LoadBalancerMonitor{
+		Type:            d.Get("type").(string),
+		Method:          d.Get("method").(string),
+		Path:            d.Get("path").(string),
+		Header:          expandLoadBalancerMonitorHeader(d.Get("header").(map[string]interface{})),
+		Port:            uint16(d.Get("port").(int)),
+		Timeout:         d.Get("timeout").(int),
+		Retries:         d.Get("retries").(int),
+		Interval:        d.Get("interval").(int),
+		ExpectedBody:    d.Get("expected_body").(string),
+		ExpectedCodes:   d.Get("expected_codes").(string),
+		FollowRedirects: d.Get("follow_redirects").(bool),
+		AllowInsecure:   d.Get("allow_insecure").(bool),
+		ProbeZone:       d.Get("probe_zone").(string),
+		Description:     d.Get("description").(string),
+	}
+
+	log.Printf("[INFO] Creating Cloudflare Load Balancer Monitor from struct: %+v", newMonitor)
+
+	r, err := client.CreateLoadBalancerMonitor(newMonitor)
+	if err != nil {
+		return errors.Wrap(err, "error creating load balancer monitor")
+	}
+
+	if r.ID == "" {
+		return fmt.Errorf("failed to find id in Create response; resource was empty")
+	}
+
+	d.SetId(r.ID)
+
+	log.Printf("[INFO] Cloudflare Load Balancer Monitor ID: %s", d.Id())
+
+	return resourceCloudflareLoadBalancerMonitorRead(d, meta)
+}
+
+func resourceCloudflareLoadBalancerMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	monitor := cloudflare.LoadBalancerMonitor{
+		ID:              d.Id(),
+		Type:            d.Get("type").(string),
+		Method:          d.Get("method").(string),
+		Path:            d.Get("path").(string),
+		Header:          expandLoadBalancerMonitorHeader(d.Get("header").(map[string]interface{})),
+		Port:            uint16(d.Get("port").(int)),
+		Timeout:         d.Get("timeout").(int),
+		Retries:         d.Get("retries").(int),
+		Interval:        d.Get("interval").(int),
+		ExpectedBody:    d.Get("expected_body").(string),
+		ExpectedCodes:   d.Get("expected_codes").(string),
+		FollowRedirects: d.Get("follow_redirects").(bool),
+		AllowInsecure:   d.Get("allow_insecure").(bool),
+		ProbeZone:       d.Get("probe_zone").(string),
+		Description:     d.Get("description").(string),
+	}
+
+	log.Printf("[INFO] Updating Cloudflare Load Balancer Monitor from struct: %+v", monitor)
+
+	_, err := client.ModifyLoadBalancerMonitor(monitor)
+	if err != nil {
+		return errors.Wrap(err, "error updating load balancer monitor")
+	}
+
+	return resourceCloudflareLoadBalancerMonitorRead(d, meta)
+}
+
+func resourceCloudflareLoadBalancerMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	monitorID := d.Id()
+
+	monitor, err := client.LoadBalancerMonitorDetails(monitorID)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Load balancer monitor %s not found", monitorID)
+			d.SetId("")
+			return nil
+		}
+		return errors.Wrap(err, fmt.Sprintf("Error reading load balancer monitor resource from API for resource %s", monitorID))
+	}
+
+	d.Set("type", monitor.Type)
+	d.Set("method", monitor.Method)
+	d.Set("path", monitor.Path)
+	d.Set("port", monitor.Port)
+	d.Set("timeout", monitor.Timeout)
+	d.Set("retries", monitor.Retries)
+	d.Set("interval", monitor.Interval)
+	d.Set("expected_body", monitor.ExpectedBody)
+	d.Set("expected_codes", monitor.ExpectedCodes)
+	d.Set("follow_redirects", monitor.FollowRedirects)
+	d.Set("allow_insecure", monitor.AllowInsecure)
+	d.Set("probe_zone", monitor.ProbeZone)
+	d.Set("description", monitor.Description)
+	d.Set("created_on", monitor.CreatedOn.Format(time.RFC3339Nano))
+	d.Set("modified_on", monitor.ModifiedOn.Format(time.RFC3339Nano))
+
+	if err := d.Set("header", flattenLoadBalancerMonitorHeader(monitor.Header)); err != nil {
+		log.Printf("[WARN] Error setting header on load balancer monitor %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareLoadBalancerMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	monitorID := d.Id()
+
+	log.Printf("[INFO] Deleting Cloudflare Load Balancer Monitor: %s", monitorID)
+
+	err := client.DeleteLoadBalancerMonitor(monitorID)
+	if err != nil {
+		return fmt.Errorf("error deleting Cloudflare Load Balancer Monitor: %s", err)
+	}
+
+	return nil
+}
+
+func expandLoadBalancerMonitorHeader(header map[string]interface{}) map[string][]string {
+	if len(header) == 0 {
+		return nil
+	}
+	expanded := make(map[string][]string, len(header))
+	for k, v := range header {
+		expanded[k] = expandInterfaceToStringList(v)
+	}
+	return expanded
+}
+
+func flattenLoadBalancerMonitorHeader(header map[string][]string) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(header))
+	for k, v := range header {
+		flattened[k] = flattenStringList(v)
+	}
+	return flattened
+}
@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareLoadBalancerMonitor_Basic(t *testing.T) {
+	var monitor cloudflare.LoadBalancerMonitor
+	rnd := acctest.RandString(10)
+	name := "cloudflare_load_balancer_monitor." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareLoadBalancerMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerMonitorConfigBasic(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerMonitorExists(name, &monitor),
+					resource.TestCheckResourceAttr(name, "type", "http"),
+					resource.TestCheckResourceAttr(name, "path", "/healthcheck"),
+					resource.TestCheckResourceAttr(name, "port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareLoadBalancerMonitorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_load_balancer_monitor" {
+			continue
+		}
+
+		_, err := client.LoadBalancerMonitorDetails(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("load balancer monitor still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareLoadBalancerMonitorExists(n string, monitor *cloudflare.LoadBalancerMonitor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no load balancer monitor ID is set")
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		foundMonitor, err := client.LoadBalancerMonitorDetails(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*monitor = foundMonitor
+		return nil
+	}
+}
+
+func testAccCheckCloudflareLoadBalancerMonitorConfigBasic(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_monitor" "%[1]s" {
+  type    = "http"
+  path    = "/healthcheck"
+  port    = 8080
+  timeout = 5
+}`, rnd)
+}
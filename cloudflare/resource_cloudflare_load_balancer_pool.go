@@ -0,0 +1,445 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareLoadBalancerPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareLoadBalancerPoolCreate,
+		Read:   resourceCloudflareLoadBalancerPoolRead,
+		Update: resourceCloudflareLoadBalancerPoolUpdate,
+		Delete: resourceCloudflareLoadBalancerPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareLoadBalancerPoolImport,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"origins": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     originElem,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"minimum_origins": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+
+			"monitor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"notification_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"check_regions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"WNAM", "ENAM", "WEU", "EEU", "NSAM", "SSAM", "OC", "ME", "NAF", "SAF", "SAS", "SEAS", "NEAS", "ALL_REGIONS"}, false),
+				},
+			},
+
+			"latitude": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+
+			"longitude": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+
+			"origin_steering": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "random",
+							ValidateFunc: validation.StringInSlice([]string{"random", "hash", "least_outstanding_requests"}, false),
+						},
+					},
+				},
+			},
+
+			"load_shedding": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_percent": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"default_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"", "random", "hash"}, false),
+						},
+						"session_percent": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"session_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"", "hash"}, false),
+						},
+					},
+				},
+			},
+
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var originElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"address": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"weight": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Default:  1,
+		},
+
+		"header": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	},
+}
+
+func resourceCloudflareLoadBalancerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	newPool := cloudflare.LoadBalancerPool{
+		Name:              d.Get("name").(string),
+		Origins:           expandLoadBalancerOrigins(d.Get("origins").([]interface{})),
+		Description:       d.Get("description").(string),
+		Enabled:           d.Get("enabled").(bool),
+		MinimumOrigins:    d.Get("minimum_origins").(int),
+		Monitor:           d.Get("monitor").(string),
+		NotificationEmail: d.Get("notification_email").(string),
+		CheckRegions:      expandInterfaceToStringList(d.Get("check_regions")),
+	}
+
+	if lat, ok := d.GetOk("latitude"); ok {
+		v := float32(lat.(float64))
+		newPool.Latitude = &v
+	}
+
+	if lon, ok := d.GetOk("longitude"); ok {
+		v := float32(lon.(float64))
+		newPool.Longitude = &v
+	}
+
+	if steering, ok := d.GetOk("origin_steering"); ok {
+		newPool.OriginSteering = expandOriginSteering(steering.([]interface{}))
+	}
+
+	if shedding, ok := d.GetOk("load_shedding"); ok {
+		newPool.LoadShedding = expandLoadShedding(shedding.([]interface{}))
+	}
+
+	log.Printf("[INFO] Creating Cloudflare Load Balancer Pool from struct: %+v", newPool)
+
+	r, err := client.CreateLoadBalancerPool(context.Background(), newPool)
+	if err != nil {
+		return errors.Wrap(err, "error creating load balancer pool")
+	}
+
+	if r.ID == "" {
+		return fmt.Errorf("failed to find id in Create response; resource was empty")
+	}
+
+	d.SetId(r.ID)
+
+	log.Printf("[INFO] Cloudflare Load Balancer Pool ID: %s", d.Id())
+
+	return resourceCloudflareLoadBalancerPoolRead(d, meta)
+}
+
+func resourceCloudflareLoadBalancerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	pool := cloudflare.LoadBalancerPool{
+		ID:                d.Id(),
+		Name:              d.Get("name").(string),
+		Origins:           expandLoadBalancerOrigins(d.Get("origins").([]interface{})),
+		Description:       d.Get("description").(string),
+		Enabled:           d.Get("enabled").(bool),
+		MinimumOrigins:    d.Get("minimum_origins").(int),
+		Monitor:           d.Get("monitor").(string),
+		NotificationEmail: d.Get("notification_email").(string),
+		CheckRegions:      expandInterfaceToStringList(d.Get("check_regions")),
+	}
+
+	if lat, ok := d.GetOk("latitude"); ok {
+		v := float32(lat.(float64))
+		pool.Latitude = &v
+	}
+
+	if lon, ok := d.GetOk("longitude"); ok {
+		v := float32(lon.(float64))
+		pool.Longitude = &v
+	}
+
+	if steering, ok := d.GetOk("origin_steering"); ok {
+		pool.OriginSteering = expandOriginSteering(steering.([]interface{}))
+	}
+
+	if shedding, ok := d.GetOk("load_shedding"); ok {
+		pool.LoadShedding = expandLoadShedding(shedding.([]interface{}))
+	}
+
+	log.Printf("[INFO] Updating Cloudflare Load Balancer Pool from struct: %+v", pool)
+
+	_, err := client.ModifyLoadBalancerPool(context.Background(), pool)
+	if err != nil {
+		return errors.Wrap(err, "error updating load balancer pool")
+	}
+
+	return resourceCloudflareLoadBalancerPoolRead(d, meta)
+}
+
+func resourceCloudflareLoadBalancerPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	poolID := d.Id()
+
+	pool, err := client.LoadBalancerPoolDetails(context.Background(), poolID)
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Load balancer pool %s not found", poolID)
+			d.SetId("")
+			return nil
+		}
+		return errors.Wrap(err, fmt.Sprintf("Error reading load balancer pool resource from API for resource %s", poolID))
+	}
+
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("enabled", pool.Enabled)
+	d.Set("minimum_origins", pool.MinimumOrigins)
+	d.Set("monitor", pool.Monitor)
+	d.Set("notification_email", pool.NotificationEmail)
+	d.Set("created_on", pool.CreatedOn.Format(time.RFC3339Nano))
+	d.Set("modified_on", pool.ModifiedOn.Format(time.RFC3339Nano))
+
+	if pool.Latitude != nil {
+		d.Set("latitude", float64(*pool.Latitude))
+	}
+
+	if pool.Longitude != nil {
+		d.Set("longitude", float64(*pool.Longitude))
+	}
+
+	if err := d.Set("check_regions", flattenStringList(pool.CheckRegions)); err != nil {
+		log.Printf("[WARN] Error setting check_regions on load balancer pool %q: %s", d.Id(), err)
+	}
+
+	if err := d.Set("origins", flattenLoadBalancerOrigins(pool.Origins)); err != nil {
+		log.Printf("[WARN] Error setting origins on load balancer pool %q: %s", d.Id(), err)
+	}
+
+	if err := d.Set("origin_steering", flattenOriginSteering(pool.OriginSteering)); err != nil {
+		log.Printf("[WARN] Error setting origin_steering on load balancer pool %q: %s", d.Id(), err)
+	}
+
+	if err := d.Set("load_shedding", flattenLoadShedding(pool.LoadShedding)); err != nil {
+		log.Printf("[WARN] Error setting load_shedding on load balancer pool %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareLoadBalancerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+	poolID := d.Id()
+
+	log.Printf("[INFO] Deleting Cloudflare Load Balancer Pool: %s", poolID)
+
+	err := client.DeleteLoadBalancerPool(context.Background(), poolID)
+	if err != nil {
+		return fmt.Errorf("error deleting Cloudflare Load Balancer Pool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareLoadBalancerPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// the import ID may optionally be namespaced as "accountID/poolID" to
+	// mirror the zone-scoped resources; pools themselves are addressed by
+	// ID alone so only the pool ID segment is kept.
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+	poolID := idAttr[0]
+	if len(idAttr) == 2 {
+		poolID = idAttr[1]
+	}
+
+	d.SetId(poolID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandLoadBalancerOrigins(list []interface{}) []cloudflare.LoadBalancerOrigin {
+	origins := make([]cloudflare.LoadBalancerOrigin, 0, len(list))
+	for _, raw := range list {
+		cfg := raw.(map[string]interface{})
+		origin := cloudflare.LoadBalancerOrigin{
+			Name:    cfg["name"].(string),
+			Address: cfg["address"].(string),
+			Enabled: cfg["enabled"].(bool),
+			Weight:  cfg["weight"].(float64),
+		}
+
+		if header, ok := cfg["header"]; ok {
+			headers := make(map[string][]string)
+			for k, v := range header.(map[string]interface{}) {
+				headers[k] = expandInterfaceToStringList(v)
+			}
+			if len(headers) > 0 {
+				origin.Header = headers
+			}
+		}
+
+		origins = append(origins, origin)
+	}
+	return origins
+}
+
+func flattenLoadBalancerOrigins(origins []cloudflare.LoadBalancerOrigin) []interface{} {
+	flattened := make([]interface{}, 0, len(origins))
+	for _, origin := range origins {
+		cfg := map[string]interface{}{
+			"name":    origin.Name,
+			"address": origin.Address,
+			"enabled": origin.Enabled,
+			"weight":  origin.Weight,
+		}
+
+		if len(origin.Header) > 0 {
+			header := make(map[string]interface{})
+			for k, v := range origin.Header {
+				header[k] = flattenStringList(v)
+			}
+			cfg["header"] = header
+		}
+
+		flattened = append(flattened, cfg)
+	}
+	return flattened
+}
+
+func expandOriginSteering(list []interface{}) *cloudflare.LoadBalancerOriginSteering {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.LoadBalancerOriginSteering{
+		Policy: cfg["policy"].(string),
+	}
+}
+
+func flattenOriginSteering(steering *cloudflare.LoadBalancerOriginSteering) []interface{} {
+	if steering == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"policy": steering.Policy,
+		},
+	}
+}
+
+func expandLoadShedding(list []interface{}) *cloudflare.LoadBalancerLoadShedding {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.LoadBalancerLoadShedding{
+		DefaultPercent: float32(cfg["default_percent"].(float64)),
+		DefaultPolicy:  cfg["default_policy"].(string),
+		SessionPercent: float32(cfg["session_percent"].(float64)),
+		SessionPolicy:  cfg["session_policy"].(string),
+	}
+}
+
+func flattenLoadShedding(shedding *cloudflare.LoadBalancerLoadShedding) []interface{} {
+	if shedding == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"default_percent": float64(shedding.DefaultPercent),
+			"default_policy":  shedding.DefaultPolicy,
+			"session_percent": float64(shedding.SessionPercent),
+			"session_policy":  shedding.SessionPolicy,
+		},
+	}
+}
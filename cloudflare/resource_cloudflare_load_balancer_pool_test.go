@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareLoadBalancerPool_Basic(t *testing.T) {
+	var pool cloudflare.LoadBalancerPool
+	rnd := acctest.RandString(10)
+	name := "cloudflare_load_balancer_pool." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareLoadBalancerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigBasic(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerPoolExists(name, &pool),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "origins.#", "1"),
+					resource.TestCheckResourceAttr(name, "origins.0.name", "example"),
+					resource.TestCheckResourceAttr(name, "origins.0.address", "192.0.2.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareLoadBalancerPoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_load_balancer_pool" {
+			continue
+		}
+
+		_, err := client.LoadBalancerPoolDetails(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("load balancer pool still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareLoadBalancerPoolExists(n string, pool *cloudflare.LoadBalancerPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no load balancer pool ID is set")
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		foundPool, err := client.LoadBalancerPoolDetails(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*pool = foundPool
+		return nil
+	}
+}
+
+func testAccCheckCloudflareLoadBalancerPoolConfigBasic(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name = "%[1]s"
+
+  origins {
+    name    = "example"
+    address = "192.0.2.1"
+    enabled = true
+  }
+
+  check_regions = ["WNAM"]
+}`, rnd)
+}
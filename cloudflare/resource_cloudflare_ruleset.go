@@ -0,0 +1,654 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/pkg/errors"
+)
+
+func resourceCloudflareRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudflareRulesetCreate,
+		Read:   resourceCloudflareRulesetRead,
+		Update: resourceCloudflareRulesetUpdate,
+		Delete: resourceCloudflareRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudflareRulesetImport,
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"account_id"},
+			},
+
+			"account_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone_id"},
+			},
+
+			"name": {
+				// the API only accepts `description` and `rules` on update, so
+				// renaming a ruleset requires replacing it.
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kind": {
+				// not accepted by UpdateZoneRuleset/UpdateAccountRuleset, so it
+				// can only be set at creation time.
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"root", "zone", "custom", "managed"}, false),
+			},
+
+			"phase": {
+				// not accepted by UpdateZoneRuleset/UpdateAccountRuleset, so it
+				// can only be set at creation time.
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"http_request_firewall_custom",
+					"http_request_dynamic_redirect",
+					"http_request_transform",
+					"http_response_headers_transform",
+					"http_ratelimit",
+				}, false),
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     rulesetRuleElem,
+			},
+		},
+	}
+}
+
+var rulesetRuleElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"ref": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"expression": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"action": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"block", "challenge", "skip", "redirect", "rewrite", "set_config", "serve_error", "score", "log", "execute"}, false),
+		},
+
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"action_parameters": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     rulesetRuleActionParametersElem,
+		},
+	},
+}
+
+var rulesetRuleActionParametersElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"products": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"phases": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		"rules": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		"headers": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     rulesetRuleActionParametersHeaderElem,
+		},
+
+		"uri": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     rulesetRuleActionParametersURIElem,
+		},
+
+		"from_value": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     rulesetRuleActionParametersFromValueElem,
+		},
+	},
+}
+
+var rulesetRuleActionParametersHeaderElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+
+		"operation": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"remove", "set", "add"}, false),
+		},
+
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"expression": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var rulesetRuleActionParametersURIElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"path": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     rulesetRuleActionParametersURIComponentElem,
+		},
+
+		"query": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     rulesetRuleActionParametersURIComponentElem,
+		},
+	},
+}
+
+var rulesetRuleActionParametersURIComponentElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"expression": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	},
+}
+
+var rulesetRuleActionParametersFromValueElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"status_code": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 65535),
+		},
+
+		"preserve_query_string": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+
+		"target_url": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     rulesetRuleActionParametersURIComponentElem,
+		},
+	},
+}
+
+func resourceCloudflareRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+	if zoneID == "" && accountID == "" {
+		return fmt.Errorf("either `zone_id` or `account_id` must be set")
+	}
+
+	newRuleset := cloudflare.Ruleset{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Kind:        d.Get("kind").(string),
+		Phase:       d.Get("phase").(string),
+		Rules:       expandRulesetRules(d),
+	}
+
+	log.Printf("[INFO] Creating Cloudflare Ruleset from struct: %+v", newRuleset)
+
+	var r cloudflare.Ruleset
+	var err error
+	if zoneID != "" {
+		r, err = client.CreateZoneRuleset(context.Background(), zoneID, newRuleset)
+	} else {
+		r, err = client.CreateAccountRuleset(context.Background(), accountID, newRuleset)
+	}
+	if err != nil {
+		return errors.Wrap(err, "error creating ruleset")
+	}
+
+	if r.ID == "" {
+		return fmt.Errorf("failed to find id in Create response; resource was empty")
+	}
+
+	d.SetId(r.ID)
+
+	log.Printf("[INFO] Cloudflare Ruleset ID: %s", d.Id())
+
+	return resourceCloudflareRulesetRead(d, meta)
+}
+
+func resourceCloudflareRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Id()
+	description := d.Get("description").(string)
+	rules := expandRulesetRules(d)
+
+	log.Printf("[INFO] Updating Cloudflare Ruleset %s description: %q rules: %+v", rulesetID, description, rules)
+
+	// name/kind/phase are ForceNew: the API only accepts description and
+	// rules on update.
+	var err error
+	if zoneID != "" {
+		_, err = client.UpdateZoneRuleset(context.Background(), zoneID, rulesetID, description, rules)
+	} else {
+		_, err = client.UpdateAccountRuleset(context.Background(), accountID, rulesetID, description, rules)
+	}
+	if err != nil {
+		return errors.Wrap(err, "error updating ruleset")
+	}
+
+	return resourceCloudflareRulesetRead(d, meta)
+}
+
+func resourceCloudflareRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Id()
+
+	var ruleset cloudflare.Ruleset
+	var err error
+	if zoneID != "" {
+		ruleset, err = client.GetZoneRuleset(context.Background(), zoneID, rulesetID)
+	} else {
+		ruleset, err = client.GetAccountRuleset(context.Background(), accountID, rulesetID)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "HTTP status 404") {
+			log.Printf("[INFO] Ruleset %s not found", rulesetID)
+			d.SetId("")
+			return nil
+		}
+		return errors.Wrap(err, fmt.Sprintf("Error reading ruleset resource from API for resource %s", rulesetID))
+	}
+
+	d.Set("name", ruleset.Name)
+	d.Set("description", ruleset.Description)
+	d.Set("kind", ruleset.Kind)
+	d.Set("phase", ruleset.Phase)
+
+	if err := d.Set("rules", flattenRulesetRules(ruleset.Rules)); err != nil {
+		log.Printf("[WARN] Error setting rules on ruleset %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*cloudflare.API)
+
+	zoneID := d.Get("zone_id").(string)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Id()
+
+	log.Printf("[INFO] Deleting Cloudflare Ruleset: %s", rulesetID)
+
+	var err error
+	if zoneID != "" {
+		err = client.DeleteZoneRuleset(context.Background(), zoneID, rulesetID)
+	} else {
+		err = client.DeleteAccountRuleset(context.Background(), accountID, rulesetID)
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Cloudflare Ruleset: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareRulesetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// the import ID is namespaced as either "zone_id/rulesetID" or
+	// "account_id/rulesetID" since rulesets can be scoped to either.
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+	if len(idAttr) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/rulesetID\" or \"accountID/rulesetID\"", d.Id())
+	}
+
+	scopeID := idAttr[0]
+	rulesetID := idAttr[1]
+
+	client := meta.(*cloudflare.API)
+	if _, err := client.GetZoneRuleset(context.Background(), scopeID, rulesetID); err == nil {
+		d.Set("zone_id", scopeID)
+	} else {
+		d.Set("account_id", scopeID)
+	}
+
+	d.SetId(rulesetID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandRulesetRules builds the rules to send to the API from the current
+// config. Rules are diffed against the prior state by `ref` (falling back to
+// positional matching when a rule's ref hasn't been assigned yet) so that
+// re-applying an otherwise-unmodified ruleset doesn't reshuffle or duplicate
+// rules the API already knows about.
+func expandRulesetRules(d *schema.ResourceData) []cloudflare.RulesetRule {
+	oldRaw, newRaw := d.GetChange("rules")
+	oldList := oldRaw.([]interface{})
+	newList := newRaw.([]interface{})
+
+	oldByRef := make(map[string]map[string]interface{}, len(oldList))
+	for _, raw := range oldList {
+		if cfg, ok := raw.(map[string]interface{}); ok {
+			if ref, ok := cfg["ref"].(string); ok && ref != "" {
+				oldByRef[ref] = cfg
+			}
+		}
+	}
+
+	rules := make([]cloudflare.RulesetRule, 0, len(newList))
+	for i, raw := range newList {
+		cfg := raw.(map[string]interface{})
+		ref := cfg["ref"].(string)
+
+		if ref == "" {
+			if i < len(oldList) {
+				if oldCfg, ok := oldList[i].(map[string]interface{}); ok {
+					ref = oldCfg["ref"].(string)
+				}
+			}
+		} else if _, ok := oldByRef[ref]; !ok {
+			ref = ""
+		}
+
+		rule := cloudflare.RulesetRule{
+			Ref:         ref,
+			Description: cfg["description"].(string),
+			Expression:  cfg["expression"].(string),
+			Action:      cfg["action"].(string),
+			Enabled:     cfg["enabled"].(bool),
+		}
+
+		if params, ok := cfg["action_parameters"].([]interface{}); ok && len(params) > 0 {
+			rule.ActionParameters = expandRulesetRuleActionParameters(params)
+		}
+
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenRulesetRules(rules []cloudflare.RulesetRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		cfg := map[string]interface{}{
+			"ref":         rule.Ref,
+			"description": rule.Description,
+			"expression":  rule.Expression,
+			"action":      rule.Action,
+			"enabled":     rule.Enabled,
+		}
+
+		if rule.ActionParameters != nil {
+			cfg["action_parameters"] = flattenRulesetRuleActionParameters(rule.ActionParameters)
+		}
+
+		flattened = append(flattened, cfg)
+	}
+	return flattened
+}
+
+func expandRulesetRuleActionParameters(list []interface{}) *cloudflare.RulesetRuleActionParameters {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+
+	params := &cloudflare.RulesetRuleActionParameters{
+		ID:       cfg["id"].(string),
+		Products: expandInterfaceToStringList(cfg["products"]),
+		Phases:   expandInterfaceToStringList(cfg["phases"]),
+	}
+
+	if rules, ok := cfg["rules"].(map[string]interface{}); ok && len(rules) > 0 {
+		params.Rules = make(map[string][]string, len(rules))
+		for k, v := range rules {
+			params.Rules[k] = expandInterfaceToStringList(v)
+		}
+	}
+
+	if headers, ok := cfg["headers"].([]interface{}); ok && len(headers) > 0 {
+		params.Headers = expandRulesetRuleActionParametersHeaders(headers)
+	}
+
+	if uri, ok := cfg["uri"].([]interface{}); ok && len(uri) > 0 {
+		params.URI = expandRulesetRuleActionParametersURI(uri)
+	}
+
+	if fromValue, ok := cfg["from_value"].([]interface{}); ok && len(fromValue) > 0 {
+		params.FromValue = expandRulesetRuleActionParametersFromValue(fromValue)
+	}
+
+	return params
+}
+
+func flattenRulesetRuleActionParameters(params *cloudflare.RulesetRuleActionParameters) []interface{} {
+	cfg := map[string]interface{}{
+		"id":       params.ID,
+		"products": flattenStringList(params.Products),
+		"phases":   flattenStringList(params.Phases),
+	}
+
+	if len(params.Rules) > 0 {
+		rules := make(map[string]interface{}, len(params.Rules))
+		for k, v := range params.Rules {
+			rules[k] = flattenStringList(v)
+		}
+		cfg["rules"] = rules
+	}
+
+	if len(params.Headers) > 0 {
+		cfg["headers"] = flattenRulesetRuleActionParametersHeaders(params.Headers)
+	}
+
+	if params.URI != nil {
+		cfg["uri"] = flattenRulesetRuleActionParametersURI(params.URI)
+	}
+
+	if params.FromValue != nil {
+		cfg["from_value"] = flattenRulesetRuleActionParametersFromValue(params.FromValue)
+	}
+
+	return []interface{}{cfg}
+}
+
+func expandRulesetRuleActionParametersHeaders(list []interface{}) map[string]cloudflare.RulesetRuleActionParametersHTTPHeader {
+	headers := make(map[string]cloudflare.RulesetRuleActionParametersHTTPHeader, len(list))
+	for _, raw := range list {
+		cfg := raw.(map[string]interface{})
+		headers[cfg["name"].(string)] = cloudflare.RulesetRuleActionParametersHTTPHeader{
+			Operation:  cfg["operation"].(string),
+			Value:      cfg["value"].(string),
+			Expression: cfg["expression"].(string),
+		}
+	}
+	return headers
+}
+
+func flattenRulesetRuleActionParametersHeaders(headers map[string]cloudflare.RulesetRuleActionParametersHTTPHeader) []interface{} {
+	flattened := make([]interface{}, 0, len(headers))
+	for name, header := range headers {
+		flattened = append(flattened, map[string]interface{}{
+			"name":       name,
+			"operation":  header.Operation,
+			"value":      header.Value,
+			"expression": header.Expression,
+		})
+	}
+	return flattened
+}
+
+func expandRulesetRuleActionParametersURIComponent(list []interface{}) *cloudflare.RulesetRuleActionParametersURIComponent {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.RulesetRuleActionParametersURIComponent{
+		Value:      cfg["value"].(string),
+		Expression: cfg["expression"].(string),
+	}
+}
+
+func flattenRulesetRuleActionParametersURIComponent(component *cloudflare.RulesetRuleActionParametersURIComponent) []interface{} {
+	if component == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"value":      component.Value,
+			"expression": component.Expression,
+		},
+	}
+}
+
+func expandRulesetRuleActionParametersURI(list []interface{}) *cloudflare.RulesetRuleActionParametersURI {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.RulesetRuleActionParametersURI{
+		Path:  expandRulesetRuleActionParametersURIComponent(cfg["path"].([]interface{})),
+		Query: expandRulesetRuleActionParametersURIComponent(cfg["query"].([]interface{})),
+	}
+}
+
+func flattenRulesetRuleActionParametersURI(uri *cloudflare.RulesetRuleActionParametersURI) []interface{} {
+	if uri == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"path":  flattenRulesetRuleActionParametersURIComponent(uri.Path),
+			"query": flattenRulesetRuleActionParametersURIComponent(uri.Query),
+		},
+	}
+}
+
+func expandRulesetRuleActionParametersTargetURL(list []interface{}) cloudflare.RulesetRuleActionParametersTargetURL {
+	if len(list) == 0 || list[0] == nil {
+		return cloudflare.RulesetRuleActionParametersTargetURL{}
+	}
+	cfg := list[0].(map[string]interface{})
+	return cloudflare.RulesetRuleActionParametersTargetURL{
+		Value:      cfg["value"].(string),
+		Expression: cfg["expression"].(string),
+	}
+}
+
+func flattenRulesetRuleActionParametersTargetURL(targetURL cloudflare.RulesetRuleActionParametersTargetURL) []interface{} {
+	if targetURL.Value == "" && targetURL.Expression == "" {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"value":      targetURL.Value,
+			"expression": targetURL.Expression,
+		},
+	}
+}
+
+func expandRulesetRuleActionParametersFromValue(list []interface{}) *cloudflare.RulesetRuleActionParametersFromValue {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	cfg := list[0].(map[string]interface{})
+	return &cloudflare.RulesetRuleActionParametersFromValue{
+		StatusCode:          uint16(cfg["status_code"].(int)),
+		PreserveQueryString: cfg["preserve_query_string"].(bool),
+		TargetURL:           expandRulesetRuleActionParametersTargetURL(cfg["target_url"].([]interface{})),
+	}
+}
+
+func flattenRulesetRuleActionParametersFromValue(fromValue *cloudflare.RulesetRuleActionParametersFromValue) []interface{} {
+	if fromValue == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"status_code":           fromValue.StatusCode,
+			"preserve_query_string": fromValue.PreserveQueryString,
+			"target_url":            flattenRulesetRuleActionParametersTargetURL(fromValue.TargetURL),
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudflareRuleset_Basic(t *testing.T) {
+	var ruleset cloudflare.Ruleset
+	rnd := acctest.RandString(10)
+	name := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudflareRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetConfigBasic(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRulesetExists(name, &ruleset),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "kind", "zone"),
+					resource.TestCheckResourceAttr(name, "phase", "http_request_firewall_custom"),
+					resource.TestCheckResourceAttr(name, "rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "rules.0.action", "block"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareRulesetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_ruleset" {
+			continue
+		}
+
+		_, err := client.GetZoneRuleset(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("ruleset still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudflareRulesetExists(n string, ruleset *cloudflare.Ruleset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ruleset ID is set")
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		foundRuleset, err := client.GetZoneRuleset(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*ruleset = foundRuleset
+		return nil
+	}
+}
+
+func testAccCheckCloudflareRulesetConfigBasic(rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_ruleset" "%[1]s" {
+  zone_id     = "%[2]s"
+  name        = "%[1]s"
+  description = "managed by terraform"
+  kind        = "zone"
+  phase       = "http_request_firewall_custom"
+
+  rules {
+    expression = "true"
+    action     = "block"
+    enabled    = true
+  }
+}`, rnd, os.Getenv("CLOUDFLARE_ZONE_ID"))
+}